@@ -0,0 +1,98 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/thedevsaddam/renderer"
+)
+
+// credentials is the request body for both /auth/register and /auth/login.
+type credentials struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+func authHandlers() http.Handler {
+	router := chi.NewRouter()
+	router.Post("/register", registerUser)
+	router.Post("/login", loginUser)
+	return router
+}
+
+func registerUser(w http.ResponseWriter, r *http.Request) {
+	var c credentials
+	if err := json.NewDecoder(r.Body).Decode(&c); err != nil {
+		rnd.JSON(w, http.StatusProcessing, err)
+		return
+	}
+	if c.Username == "" || c.Password == "" {
+		rnd.JSON(w, http.StatusBadRequest, renderer.M{
+			"message": "Username and password are required!",
+		})
+		return
+	}
+
+	u, err := users.Create(r.Context(), c.Username, c.Password)
+	if err == ErrUserExists {
+		rnd.JSON(w, http.StatusConflict, renderer.M{
+			"message": "Username is already taken!",
+		})
+		return
+	}
+	if err != nil {
+		rnd.JSON(w, http.StatusProcessing, renderer.M{
+			"message": "Failed to register user.",
+			"error":   err,
+		})
+		return
+	}
+
+	token, err := issueToken(u.ID.Hex())
+	if err != nil {
+		rnd.JSON(w, http.StatusProcessing, renderer.M{
+			"message": "Failed to issue token.",
+		})
+		return
+	}
+	rnd.JSON(w, http.StatusCreated, renderer.M{
+		"message": "User registered successfully!",
+		"token":   token,
+	})
+}
+
+func loginUser(w http.ResponseWriter, r *http.Request) {
+	var c credentials
+	if err := json.NewDecoder(r.Body).Decode(&c); err != nil {
+		rnd.JSON(w, http.StatusProcessing, err)
+		return
+	}
+
+	u, err := users.Authenticate(r.Context(), c.Username, c.Password)
+	if err == ErrInvalidCredentials {
+		rnd.JSON(w, http.StatusUnauthorized, renderer.M{
+			"message": "Invalid username or password!",
+		})
+		return
+	}
+	if err != nil {
+		rnd.JSON(w, http.StatusProcessing, renderer.M{
+			"message": "Failed to log in.",
+			"error":   err,
+		})
+		return
+	}
+
+	token, err := issueToken(u.ID.Hex())
+	if err != nil {
+		rnd.JSON(w, http.StatusProcessing, renderer.M{
+			"message": "Failed to issue token.",
+		})
+		return
+	}
+	rnd.JSON(w, http.StatusOK, renderer.M{
+		"message": "Logged in successfully!",
+		"token":   token,
+	})
+}
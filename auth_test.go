@@ -0,0 +1,233 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/thedevsaddam/renderer"
+)
+
+// TestMain sets a fixed JWT_SECRET for the whole test binary: jwtSecret
+// fails closed when it's unset, and issueToken/parseToken are exercised by
+// nearly every test in this file.
+func TestMain(m *testing.M) {
+	os.Setenv("JWT_SECRET", "test-secret")
+	os.Exit(m.Run())
+}
+
+func TestAuthRequired_MissingToken(t *testing.T) {
+	rnd = renderer.New()
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/todo", nil)
+
+	called := false
+	handler := AuthRequired()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	handler.ServeHTTP(rec, req)
+
+	if called {
+		t.Fatal("handler should not run without a bearer token")
+	}
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("want 401, got %d", rec.Code)
+	}
+}
+
+func TestAuthRequired_ExpiredToken(t *testing.T) {
+	rnd = renderer.New()
+	os.Setenv("JWT_TTL", "1ms")
+	defer os.Unsetenv("JWT_TTL")
+
+	token, err := issueToken("user-1")
+	if err != nil {
+		t.Fatalf("issueToken: %v", err)
+	}
+	time.Sleep(5 * time.Millisecond)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/todo", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	called := false
+	handler := AuthRequired()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	handler.ServeHTTP(rec, req)
+
+	if called {
+		t.Fatal("handler should not run with an expired token")
+	}
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("want 401, got %d", rec.Code)
+	}
+}
+
+func TestAuthRequired_ValidToken(t *testing.T) {
+	rnd = renderer.New()
+
+	token, err := issueToken("user-1")
+	if err != nil {
+		t.Fatalf("issueToken: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/todo", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	var gotUserID string
+	handler := AuthRequired()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUserID = userIDFromContext(r.Context())
+	}))
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("want 200, got %d", rec.Code)
+	}
+	if gotUserID != "user-1" {
+		t.Fatalf("want userID %q, got %q", "user-1", gotUserID)
+	}
+}
+
+// fakeTodoStore is an in-memory TodoStore used only to exercise the
+// per-user scoping that todoHandlers relies on, without a real database.
+type fakeTodoStore struct {
+	mu    sync.Mutex
+	todos map[string]todo
+}
+
+func newFakeTodoStore() *fakeTodoStore {
+	return &fakeTodoStore{todos: map[string]todo{}}
+}
+
+func (s *fakeTodoStore) List(ctx context.Context, userID string, params ListParams) (ListResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var todos []todo
+	for _, t := range s.todos {
+		if t.UserID == userID {
+			todos = append(todos, t)
+		}
+	}
+	return ListResult{Todos: todos, Total: len(todos)}, nil
+}
+
+func (s *fakeTodoStore) Get(ctx context.Context, userID, id string) (todo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.todos[id]
+	if !ok || t.UserID != userID {
+		return todo{}, ErrNotFound
+	}
+	return t, nil
+}
+
+func (s *fakeTodoStore) Create(ctx context.Context, userID string, t todo) (todo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t.ID = userID + "-" + t.Title
+	t.UserID = userID
+	s.todos[t.ID] = t
+	return t, nil
+}
+
+func (s *fakeTodoStore) Update(ctx context.Context, userID, id string, t todo) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	existing, ok := s.todos[id]
+	if !ok || existing.UserID != userID {
+		return ErrNotFound
+	}
+	existing.Title = t.Title
+	existing.Completed = t.Completed
+	s.todos[id] = existing
+	return nil
+}
+
+func (s *fakeTodoStore) Delete(ctx context.Context, userID, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	existing, ok := s.todos[id]
+	if !ok || existing.UserID != userID {
+		return ErrNotFound
+	}
+	delete(s.todos, id)
+	return nil
+}
+
+func (s *fakeTodoStore) DueTodos(ctx context.Context, asOf time.Time) ([]todo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var due []todo
+	for _, t := range s.todos {
+		if !t.Completed && !t.Reminded && t.DueAt != nil && !t.DueAt.After(asOf) {
+			due = append(due, t)
+		}
+	}
+	return due, nil
+}
+
+func (s *fakeTodoStore) MarkReminded(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	existing, ok := s.todos[id]
+	if !ok {
+		return ErrNotFound
+	}
+	existing.Reminded = true
+	s.todos[id] = existing
+	return nil
+}
+
+func TestTodoHandlers_CrossUserAccessDenied(t *testing.T) {
+	rnd = renderer.New()
+	store = newFakeTodoStore()
+
+	ownerToken, err := issueToken("owner")
+	if err != nil {
+		t.Fatalf("issueToken: %v", err)
+	}
+	otherToken, err := issueToken("intruder")
+	if err != nil {
+		t.Fatalf("issueToken: %v", err)
+	}
+
+	router := chi.NewRouter()
+	router.Mount("/todo", todoHandlers())
+
+	createReq := httptest.NewRequest(http.MethodPost, "/todo/", strings.NewReader(`{"title":"owner's secret"}`))
+	createReq.Header.Set("Authorization", "Bearer "+ownerToken)
+	createRec := httptest.NewRecorder()
+	router.ServeHTTP(createRec, createReq)
+	if createRec.Code != http.StatusCreated {
+		t.Fatalf("create: want 201, got %d: %s", createRec.Code, createRec.Body.String())
+	}
+	id := "owner-owner's secret"
+
+	getReq := httptest.NewRequest(http.MethodGet, "/todo/"+url.PathEscape(id), nil)
+	getReq.Header.Set("Authorization", "Bearer "+otherToken)
+	getRec := httptest.NewRecorder()
+	router.ServeHTTP(getRec, getReq)
+
+	if getRec.Code != http.StatusNotFound {
+		t.Fatalf("a different user reading another user's todo: want 404, got %d", getRec.Code)
+	}
+
+	ownerGetReq := httptest.NewRequest(http.MethodGet, "/todo/"+url.PathEscape(id), nil)
+	ownerGetReq.Header.Set("Authorization", "Bearer "+ownerToken)
+	ownerGetRec := httptest.NewRecorder()
+	router.ServeHTTP(ownerGetRec, ownerGetReq)
+
+	if ownerGetRec.Code != http.StatusOK {
+		t.Fatalf("the owner reading their own todo: want 200, got %d", ownerGetRec.Code)
+	}
+}
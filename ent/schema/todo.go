@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/rs/xid"
+)
+
+// Todo holds the schema definition for the Todo entity. It mirrors the
+// fields used by the Mongo and Redis TodoStore backends so the three
+// implementations stay interchangeable behind the TodoStore interface.
+type Todo struct {
+	ent.Schema
+}
+
+// Fields of the Todo.
+func (Todo) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string { return xid.New().String() }).
+			Unique().
+			Immutable(),
+		field.String("title").
+			NotEmpty(),
+		field.Bool("completed").
+			Default(false),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("due_at").
+			Optional().
+			Nillable(),
+		field.Bool("reminded").
+			Default(false),
+		field.String("owner_id").
+			NotEmpty().
+			Immutable(),
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"errors"
+	"log"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const defaultTokenTTL = 24 * time.Hour
+
+// jwtClaims is the payload embedded in every access token this service
+// issues. Subject carries the authenticated user's id.
+type jwtClaims struct {
+	jwt.RegisteredClaims
+}
+
+// jwtSecret is read from JWT_SECRET on every call so tests can override it
+// via os.Setenv without restarting the process. There is no dev fallback: a
+// known, hardcoded signing key would let anyone forge a token for any user
+// id, so a missing JWT_SECRET is a fatal configuration error rather than a
+// silent weak default.
+func jwtSecret() []byte {
+	secret := os.Getenv("JWT_SECRET")
+	if secret == "" {
+		log.Fatal("auth: JWT_SECRET must be set")
+	}
+	return []byte(secret)
+}
+
+// tokenTTL is configurable via JWT_TTL (a Go duration string, e.g. "1h"),
+// defaulting to defaultTokenTTL.
+func tokenTTL() time.Duration {
+	raw := os.Getenv("JWT_TTL")
+	if raw == "" {
+		return defaultTokenTTL
+	}
+	ttl, err := time.ParseDuration(raw)
+	if err != nil {
+		return defaultTokenTTL
+	}
+	return ttl
+}
+
+// issueToken creates an HS256-signed JWT for userID, valid for tokenTTL().
+func issueToken(userID string) (string, error) {
+	now := time.Now()
+	claims := jwtClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(tokenTTL())),
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(jwtSecret())
+}
+
+// parseToken validates tokenString (signature and expiry) and returns the
+// user id carried in its subject claim.
+func parseToken(tokenString string) (string, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &jwtClaims{}, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("auth: unexpected signing method")
+		}
+		return jwtSecret(), nil
+	})
+	if err != nil {
+		return "", err
+	}
+	claims, ok := token.Claims.(*jwtClaims)
+	if !ok || !token.Valid {
+		return "", errors.New("auth: invalid token")
+	}
+	return claims.Subject, nil
+}
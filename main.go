@@ -3,56 +3,65 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/go-chi/chi"
 	"github.com/go-chi/chi/middleware"
 	"github.com/thedevsaddam/renderer"
+)
 
-	mgo "gopkg.in/mgo.v2"
-	"gopkg.in/mgo.v2/bson"
+const (
+	defaultListLimit = 20
+	maxListLimit     = 1000
 )
 
+// sortableColumns whitelists the sort_column values every TodoStore backend
+// is guaranteed to support, so an unknown column fails fast as a 400 instead
+// of reaching the database (where it errors on SQL and is silently ignored
+// on Mongo).
+var sortableColumns = map[string]bool{
+	"created_at": true,
+	"title":      true,
+	"completed":  true,
+}
+
 var rnd *renderer.Render
-var db *mgo.Database
+
+// store is the shared TodoStore used by every handler. It's created in main
+// (not init) because the Mongo backend needs a context.Context to connect.
+var store TodoStore
+
+// users is the shared account store backing /auth/register and /auth/login.
+// It's always Mongo-backed, independent of TODO_BACKEND, since accounts
+// aren't part of the pluggable todo persistence.
+var users *userStore
 
 const (
-	hostName       string = "localhost:27017"
-	dbName         string = "go-todo"
-	collectionName string = "todos"
-	port           string = ":9000"
+	port string = ":9000"
 )
 
-type (
-	// todoModel represents the data model for a todo item in the database (MongoDB) collection "todos"
-	// The bson field tags are used by the mgo driver to map the fields of the struct to the fields in the MongoDB documents
-	todoModel struct {
-		ID        bson.ObjectId `bson:"_id, omitempty"`
-		Title     string        `bson:"title"`
-		Completed bool          `bson:"completed"`
-		CreatedAt time.Time     `bson:"created_at"`
-	}
-	// todo struct represents the data model for a todo item in the API
-	// The json field tags are used by the renderer package to serialize the struct to JSON
-	todo struct {
-		ID        string    `json:"id"`
-		Title     string    `json:"title"`
-		Completed bool      `json:"completed"`
-		CreatedAt time.Time `json:"created_at"`
-	}
-)
+// todo struct represents the data model for a todo item in the API
+// The json field tags are used by the renderer package to serialize the struct to JSON
+type todo struct {
+	ID        string     `json:"id"`
+	Title     string     `json:"title"`
+	Completed bool       `json:"completed"`
+	CreatedAt time.Time  `json:"created_at"`
+	DueAt     *time.Time `json:"due_at,omitempty"`
+	Reminded  bool       `json:"reminded"`
+	UserID    string     `json:"-"`
+}
 
 func init() {
 	rnd = renderer.New()
-	session, err := mgo.Dial(hostName)
-	checkErr(err)
-	session.SetMode(mgo.Monotonic, true)
-	db = session.DB(dbName)
 }
 
 func checkErr(err error) {
@@ -63,8 +72,10 @@ func checkErr(err error) {
 
 func todoHandlers() http.Handler {
 	router := chi.NewRouter()
+	router.Use(AuthRequired())
 	router.Group(func(r chi.Router) {
 		r.Get("/", fetchTodos)
+		r.Get("/{id}", fetchTodo)
 		r.Post("/", createTodo)
 		r.Put("/{id}", updateTodo)
 		r.Delete("/{id}", deleteTodo)
@@ -78,26 +89,114 @@ func homeHandler(w http.ResponseWriter, r *http.Request) {
 }
 
 func fetchTodos(w http.ResponseWriter, r *http.Request) {
-	todos := []todoModel{}
-	if err := db.C(collectionName).Find(bson.M{}).All(&todos); err != nil {
+	params, err := parseListParams(r.URL.Query())
+	if err != nil {
+		rnd.JSON(w, http.StatusBadRequest, renderer.M{
+			"message": err.Error(),
+		})
+		return
+	}
+
+	result, err := store.List(r.Context(), userIDFromContext(r.Context()), params)
+	if err != nil {
 		rnd.JSON(w, http.StatusProcessing, renderer.M{
 			"message": "Failed to fetch the Todos.",
 			"error":   err,
 		})
 		return
 	}
-	todoList := []todo{}
+	rnd.JSON(w, http.StatusOK, renderer.M{
+		"data":   result.Todos,
+		"total":  result.Total,
+		"limit":  params.Limit,
+		"offset": params.Offset,
+	})
+}
+
+// parseListParams turns the GET /todo query string into a ListParams,
+// applying the same defaults and bounds a TodoStore can rely on: a default
+// page size of defaultListLimit capped at maxListLimit, ascending order by
+// created_at, and no filtering.
+func parseListParams(q url.Values) (ListParams, error) {
+	params := ListParams{
+		Limit:      defaultListLimit,
+		SortColumn: "created_at",
+		SortOrder:  "asc",
+		Query:      strings.TrimSpace(q.Get("q")),
+	}
+
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit <= 0 {
+			return ListParams{}, errors.New("limit must be a positive integer")
+		}
+		if limit > maxListLimit {
+			limit = maxListLimit
+		}
+		params.Limit = limit
+	}
+
+	if v := q.Get("offset"); v != "" {
+		offset, err := strconv.Atoi(v)
+		if err != nil || offset < 0 {
+			return ListParams{}, errors.New("offset must be a non-negative integer")
+		}
+		params.Offset = offset
+	}
+
+	if v := q.Get("sort_column"); v != "" {
+		if !sortableColumns[v] {
+			return ListParams{}, errors.New("sort_column must be one of created_at, title, completed")
+		}
+		params.SortColumn = v
+	}
+
+	if v := q.Get("sort_order"); v != "" {
+		order := strings.ToLower(v)
+		if order != "asc" && order != "desc" {
+			return ListParams{}, errors.New("sort_order must be \"asc\" or \"desc\"")
+		}
+		params.SortOrder = order
+	}
+
+	if v := q.Get("completed"); v != "" {
+		completed, err := strconv.ParseBool(v)
+		if err != nil {
+			return ListParams{}, errors.New("completed must be a boolean")
+		}
+		params.Completed = &completed
+	}
+
+	return params, nil
+}
+
+func fetchTodo(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimSpace(chi.URLParam(r, "id"))
 
-	for _, t := range todos {
-		todoList = append(todoList, todo{
-			ID:        t.ID.Hex(),
-			Title:     t.Title,
-			Completed: t.Completed,
-			CreatedAt: t.CreatedAt,
+	t, err := store.Get(r.Context(), userIDFromContext(r.Context()), id)
+	if errors.Is(err, ErrInvalidID) {
+		rnd.JSON(w, http.StatusBadRequest, renderer.M{
+			"message": "Id is invalid!",
+			"todo_id": id,
+		})
+		return
+	}
+	if errors.Is(err, ErrNotFound) {
+		rnd.JSON(w, http.StatusNotFound, renderer.M{
+			"message": "No todo found with the given id!",
+			"todo_id": id,
 		})
+		return
+	}
+	if err != nil {
+		rnd.JSON(w, http.StatusProcessing, renderer.M{
+			"message": "Failed to fetch the todo.",
+			"error":   err,
+		})
+		return
 	}
 	rnd.JSON(w, http.StatusOK, renderer.M{
-		"data": todoList,
+		"data": t,
 	})
 }
 
@@ -113,13 +212,8 @@ func createTodo(w http.ResponseWriter, r *http.Request) {
 		})
 		return
 	}
-	tm := todoModel{
-		ID:        bson.NewObjectId(),
-		Title:     t.Title,
-		Completed: t.Completed,
-		CreatedAt: time.Now(),
-	}
-	if err := db.C(collectionName).Insert(tm); err != nil {
+	created, err := store.Create(r.Context(), userIDFromContext(r.Context()), t)
+	if err != nil {
 		rnd.JSON(w, http.StatusProcessing, renderer.M{
 			"message": "Failed to save todo.",
 		})
@@ -127,21 +221,28 @@ func createTodo(w http.ResponseWriter, r *http.Request) {
 	}
 	rnd.JSON(w, http.StatusCreated, renderer.M{
 		"message": "Todo created successfully!",
-		"todo_id": tm.ID.Hex(),
+		"todo_id": created.ID,
 	})
 }
 
 func deleteTodo(w http.ResponseWriter, r *http.Request) {
 	id := strings.TrimSpace(chi.URLParam(r, "id"))
 
-	if !bson.IsObjectIdHex(id) {
-		rnd.JSON(w, http.StatusBadRequest, renderer.M{
-			"message": "Id is invalid!",
-		})
-		return
-	}
-
-	if err := db.C(collectionName).RemoveId(bson.ObjectIdHex(id)); err != nil {
+	if err := store.Delete(r.Context(), userIDFromContext(r.Context()), id); err != nil {
+		if errors.Is(err, ErrInvalidID) {
+			rnd.JSON(w, http.StatusBadRequest, renderer.M{
+				"message": "Id is invalid!",
+				"todo_id": id,
+			})
+			return
+		}
+		if errors.Is(err, ErrNotFound) {
+			rnd.JSON(w, http.StatusNotFound, renderer.M{
+				"message": "No todo found with the given id!",
+				"todo_id": id,
+			})
+			return
+		}
 		rnd.JSON(w, http.StatusProcessing, renderer.M{
 			"message": "Failed to delete todo!",
 			"todo_id": id,
@@ -158,13 +259,6 @@ func deleteTodo(w http.ResponseWriter, r *http.Request) {
 func updateTodo(w http.ResponseWriter, r *http.Request) {
 	id := strings.TrimSpace(chi.URLParam(r, "id"))
 
-	if !bson.IsObjectIdHex(id) {
-		rnd.JSON(w, http.StatusBadRequest, renderer.M{
-			"message": "The id is invalid!",
-		})
-		return
-	}
-
 	var t todo
 	if err := json.NewDecoder(r.Body).Decode(&t); err != nil {
 		rnd.JSON(w, http.StatusProcessing, err)
@@ -178,13 +272,21 @@ func updateTodo(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := db.C(collectionName).Update(
-		bson.M{"_id": bson.ObjectIdHex(id)},
-		bson.M{
-			"title":     t.Title,
-			"completed": t.Completed,
-		},
-	); err != nil {
+	if err := store.Update(r.Context(), userIDFromContext(r.Context()), id, t); err != nil {
+		if errors.Is(err, ErrInvalidID) {
+			rnd.JSON(w, http.StatusBadRequest, renderer.M{
+				"message": "Id is invalid!",
+				"todo_id": id,
+			})
+			return
+		}
+		if errors.Is(err, ErrNotFound) {
+			rnd.JSON(w, http.StatusNotFound, renderer.M{
+				"message": "No todo found with the given id!",
+				"todo_id": id,
+			})
+			return
+		}
 		rnd.JSON(w, http.StatusProcessing, renderer.M{
 			"message": "Failed to update todo!",
 			"error":   err,
@@ -197,10 +299,21 @@ func main() {
 	stopChannel := make(chan os.Signal)
 	signal.Notify(stopChannel, os.Interrupt)
 
+	startupCtx, cancelStartup := context.WithTimeout(context.Background(), 10*time.Second)
+	store = newTodoStore(startupCtx)
+	var err error
+	users, err = newUserStore(startupCtx)
+	checkErr(err)
+	cancelStartup()
+
+	reminders := newReminderRunner(store, newNotifier())
+	reminders.Start()
+
 	router := chi.NewRouter()
 	router.Use(middleware.Logger)
 
 	router.Get("/", homeHandler)
+	router.Mount("/auth", authHandlers())
 	router.Mount("/todo", todoHandlers())
 
 	server := &http.Server{
@@ -219,7 +332,8 @@ func main() {
 	<-stopChannel
 	log.Println("Shutting down server...")
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	server.Shutdown(ctx)
 	defer cancel()
+	server.Shutdown(ctx)
+	reminders.Close()
 	log.Println("Server stopped.")
 }
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/thedevsaddam/renderer"
+)
+
+type contextKey string
+
+// userIDContextKey is where AuthRequired stashes the authenticated user id
+// in the request context.
+const userIDContextKey contextKey = "userID"
+
+// AuthRequired validates the bearer JWT on every request it guards and
+// injects the authenticated user's id into the request context. Handlers
+// downstream read it back with userIDFromContext. Requests without a valid,
+// unexpired token are rejected with 401 before reaching the handler.
+func AuthRequired() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header := r.Header.Get("Authorization")
+			tokenString := strings.TrimPrefix(header, "Bearer ")
+			if tokenString == "" || tokenString == header {
+				rnd.JSON(w, http.StatusUnauthorized, renderer.M{
+					"message": "Missing bearer token!",
+				})
+				return
+			}
+
+			userID, err := parseToken(tokenString)
+			if err != nil {
+				rnd.JSON(w, http.StatusUnauthorized, renderer.M{
+					"message": "Invalid or expired token!",
+				})
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), userIDContextKey, userID)))
+		})
+	}
+}
+
+// userIDFromContext returns the authenticated user id stashed by
+// AuthRequired. It panics if called outside an authenticated request, which
+// would mean a handler was mounted without going through that middleware.
+func userIDFromContext(ctx context.Context) string {
+	userID, ok := ctx.Value(userIDContextKey).(string)
+	if !ok {
+		panic("auth: handler called without AuthRequired middleware")
+	}
+	return userID
+}
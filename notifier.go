@@ -0,0 +1,71 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"time"
+)
+
+// Notifier dispatches a reminder for a todo whose DueAt has passed. Swap in
+// a different implementation to reach another channel (email, Slack, ...)
+// without touching the reminderRunner that drives it.
+type Notifier interface {
+	Notify(ctx context.Context, t todo) error
+}
+
+// logNotifier just logs the reminder. It's the default, so the worker is
+// useful out of the box with no extra configuration.
+type logNotifier struct{}
+
+func (logNotifier) Notify(ctx context.Context, t todo) error {
+	log.Printf("reminder: todo %q (id=%s) is due\n", t.Title, t.ID)
+	return nil
+}
+
+// webhookNotifier POSTs a JSON payload describing the due todo to a
+// configured URL.
+type webhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+func newWebhookNotifier(url string) *webhookNotifier {
+	return &webhookNotifier{url: url, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (n *webhookNotifier) Notify(ctx context.Context, t todo) error {
+	body, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("reminder: webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// newNotifier builds the Notifier selected via REMINDER_WEBHOOK_URL: a
+// webhookNotifier if set, otherwise the log-only default.
+func newNotifier() Notifier {
+	url := os.Getenv("REMINDER_WEBHOOK_URL")
+	if url == "" {
+		return logNotifier{}
+	}
+	return newWebhookNotifier(url)
+}
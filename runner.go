@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+)
+
+const defaultReminderInterval = time.Minute
+
+// reminderRunner periodically scans for todos whose due date has passed and
+// dispatches a Notifier for each one. It's a controllable cycle: Start
+// kicks off the loop, Trigger requests an out-of-band scan, and Close
+// cancels the loop and waits for it to exit, so main can drain it alongside
+// the HTTP server on shutdown.
+type reminderRunner struct {
+	store    TodoStore
+	notifier Notifier
+	interval time.Duration
+
+	trigger chan struct{}
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+func newReminderRunner(store TodoStore, notifier Notifier) *reminderRunner {
+	interval := defaultReminderInterval
+	if raw := os.Getenv("REMINDER_INTERVAL"); raw != "" {
+		if parsed, err := time.ParseDuration(raw); err == nil {
+			interval = parsed
+		}
+	}
+	return &reminderRunner{
+		store:    store,
+		notifier: notifier,
+		interval: interval,
+		trigger:  make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start launches the run loop in its own goroutine and returns immediately.
+func (r *reminderRunner) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	go r.run(ctx)
+}
+
+func (r *reminderRunner) run(ctx context.Context) {
+	defer close(r.done)
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.tick(ctx)
+		case <-r.trigger:
+			r.tick(ctx)
+		}
+	}
+}
+
+// Trigger requests an out-of-band scan without waiting for the next tick.
+// It's non-blocking: a scan already queued coalesces with this one.
+func (r *reminderRunner) Trigger() {
+	select {
+	case r.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// Stop cancels the run loop without waiting for it to exit.
+func (r *reminderRunner) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+}
+
+// Close stops the run loop and blocks until it has exited, so callers (e.g.
+// main during shutdown) know no scan is still in flight.
+func (r *reminderRunner) Close() {
+	r.Stop()
+	<-r.done
+}
+
+func (r *reminderRunner) tick(ctx context.Context) {
+	due, err := r.store.DueTodos(ctx, time.Now())
+	if err != nil {
+		log.Printf("reminder: failed to list due todos: %v\n", err)
+		return
+	}
+	for _, t := range due {
+		if err := r.notifier.Notify(ctx, t); err != nil {
+			log.Printf("reminder: failed to notify for todo %s: %v\n", t.ID, err)
+			continue
+		}
+		if err := r.store.MarkReminded(ctx, t.ID); err != nil {
+			log.Printf("reminder: failed to mark todo %s reminded: %v\n", t.ID, err)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"time"
+)
+
+// ErrNotFound is returned by a TodoStore when no todo matches the given id.
+var ErrNotFound = errors.New("todo: not found")
+
+// ErrInvalidID is returned by a TodoStore when id isn't even the right
+// shape for the backend (e.g. not a 24-character hex string for Mongo, or
+// not a valid xid for Redis/SQL), so callers can tell a malformed id apart
+// from a well-formed one that simply doesn't match any todo.
+var ErrInvalidID = errors.New("todo: invalid id")
+
+// TodoStore abstracts the persistence of todos so the HTTP handlers don't
+// need to know which database backend is actually storing the data. Every
+// method is scoped to userID, the authenticated owner of the todo, so one
+// account can never read or modify another account's todos.
+type TodoStore interface {
+	List(ctx context.Context, userID string, params ListParams) (ListResult, error)
+	Get(ctx context.Context, userID, id string) (todo, error)
+	Create(ctx context.Context, userID string, t todo) (todo, error)
+	Update(ctx context.Context, userID, id string, t todo) error
+	Delete(ctx context.Context, userID, id string) error
+
+	// DueTodos returns todos across every user whose DueAt has passed
+	// asOf and that haven't been reminded yet. Unlike the methods above,
+	// it isn't scoped to a single user: it backs the background reminder
+	// worker, which runs outside any one request's authentication.
+	DueTodos(ctx context.Context, asOf time.Time) ([]todo, error)
+	// MarkReminded flags the todo identified by id as reminded, so the
+	// reminder worker doesn't notify about it again.
+	MarkReminded(ctx context.Context, id string) error
+}
+
+// ListParams controls pagination, filtering and sorting for TodoStore.List.
+// SortColumn and SortOrder are always populated with a default by the
+// caller (see parseListParams in main.go) so backends don't need to guess.
+type ListParams struct {
+	Limit      int
+	Offset     int
+	SortColumn string
+	SortOrder  string
+	Completed  *bool
+	Query      string
+}
+
+// ListResult is the page of todos returned by TodoStore.List, plus the total
+// number of todos matching the filter (ignoring Limit/Offset) so callers
+// can build paging controls.
+type ListResult struct {
+	Todos []todo
+	Total int
+}
+
+// newTodoStore builds the TodoStore selected via the TODO_BACKEND
+// environment variable. Supported values are "mongo" (the default), "redis"
+// and "sql". An unrecognised value, or a backend that fails to initialise,
+// is a fatal configuration error. ctx bounds the Mongo backend's startup
+// work (its initial connection and ping); the redis and sql backends do not
+// use it.
+func newTodoStore(ctx context.Context) TodoStore {
+	switch backend := os.Getenv("TODO_BACKEND"); backend {
+	case "", "mongo":
+		store, err := newMongoTodoStore(ctx)
+		checkErr(err)
+		return store
+	case "redis":
+		return newRedisTodoStore()
+	case "sql":
+		return newSQLTodoStore()
+	default:
+		log.Fatalf("todo: unknown TODO_BACKEND %q", backend)
+		return nil
+	}
+}
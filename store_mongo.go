@@ -0,0 +1,215 @@
+package main
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	mongoURI       string = "mongodb://localhost:27017"
+	dbName         string = "go-todo"
+	collectionName string = "todos"
+)
+
+// todoModel represents the data model for a todo item in the database (MongoDB) collection "todos"
+// The bson field tags are used by the mongo driver to map the fields of the struct to the fields in the MongoDB documents
+type todoModel struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	Title     string             `bson:"title"`
+	Completed bool               `bson:"completed"`
+	CreatedAt time.Time          `bson:"created_at"`
+	DueAt     *time.Time         `bson:"due_at,omitempty"`
+	Reminded  bool               `bson:"reminded"`
+	UserID    string             `bson:"user_id"`
+}
+
+// mongoTodoStore is the original TodoStore backend, backed by the official
+// go.mongodb.org/mongo-driver client. The *mongo.Collection it wraps is
+// created once in main and shared across requests; every method threads
+// the request's context.Context down to the driver so cancellation and
+// deadlines propagate all the way to MongoDB.
+type mongoTodoStore struct {
+	collection *mongo.Collection
+}
+
+func newMongoTodoStore(ctx context.Context) (*mongoTodoStore, error) {
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(mongoURI))
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		return nil, err
+	}
+	collection := client.Database(dbName).Collection(collectionName)
+	return &mongoTodoStore{collection: collection}, nil
+}
+
+func (s *mongoTodoStore) List(ctx context.Context, userID string, params ListParams) (ListResult, error) {
+	filter := bson.M{"user_id": userID}
+	if params.Completed != nil {
+		filter["completed"] = *params.Completed
+	}
+	if params.Query != "" {
+		filter["title"] = bson.M{"$regex": primitive.Regex{Pattern: regexp.QuoteMeta(params.Query), Options: "i"}}
+	}
+
+	total, err := s.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return ListResult{}, err
+	}
+
+	sortOrder := 1
+	if params.SortOrder == "desc" {
+		sortOrder = -1
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: params.SortColumn, Value: sortOrder}}).
+		SetSkip(int64(params.Offset)).
+		SetLimit(int64(params.Limit))
+
+	cursor, err := s.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return ListResult{}, err
+	}
+	defer cursor.Close(ctx)
+
+	models := []todoModel{}
+	if err := cursor.All(ctx, &models); err != nil {
+		return ListResult{}, err
+	}
+	todos := make([]todo, 0, len(models))
+	for _, m := range models {
+		todos = append(todos, mongoModelToTodo(m))
+	}
+	return ListResult{Todos: todos, Total: int(total)}, nil
+}
+
+func (s *mongoTodoStore) Get(ctx context.Context, userID, id string) (todo, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return todo{}, ErrInvalidID
+	}
+	var m todoModel
+	if err := s.collection.FindOne(ctx, bson.M{"_id": oid, "user_id": userID}).Decode(&m); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return todo{}, ErrNotFound
+		}
+		return todo{}, err
+	}
+	return mongoModelToTodo(m), nil
+}
+
+func (s *mongoTodoStore) Create(ctx context.Context, userID string, t todo) (todo, error) {
+	m := todoModel{
+		ID:        primitive.NewObjectID(),
+		Title:     t.Title,
+		Completed: t.Completed,
+		CreatedAt: time.Now(),
+		DueAt:     t.DueAt,
+		UserID:    userID,
+	}
+	if _, err := s.collection.InsertOne(ctx, m); err != nil {
+		return todo{}, err
+	}
+	return mongoModelToTodo(m), nil
+}
+
+// Update resets reminded to false on every edit (not just a due_at change),
+// since the reminder worker should get another chance to notify about
+// whatever the todo now looks like.
+func (s *mongoTodoStore) Update(ctx context.Context, userID, id string, t todo) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrInvalidID
+	}
+	res, err := s.collection.UpdateOne(ctx,
+		bson.M{"_id": oid, "user_id": userID},
+		bson.M{"$set": bson.M{
+			"title":     t.Title,
+			"completed": t.Completed,
+			"due_at":    t.DueAt,
+			"reminded":  false,
+		}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *mongoTodoStore) Delete(ctx context.Context, userID, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrInvalidID
+	}
+	res, err := s.collection.DeleteOne(ctx, bson.M{"_id": oid, "user_id": userID})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *mongoTodoStore) DueTodos(ctx context.Context, asOf time.Time) ([]todo, error) {
+	filter := bson.M{
+		"completed": false,
+		"reminded":  false,
+		"due_at":    bson.M{"$lte": asOf},
+	}
+	cursor, err := s.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	models := []todoModel{}
+	if err := cursor.All(ctx, &models); err != nil {
+		return nil, err
+	}
+	todos := make([]todo, 0, len(models))
+	for _, m := range models {
+		todos = append(todos, mongoModelToTodo(m))
+	}
+	return todos, nil
+}
+
+func (s *mongoTodoStore) MarkReminded(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrInvalidID
+	}
+	res, err := s.collection.UpdateOne(ctx,
+		bson.M{"_id": oid},
+		bson.M{"$set": bson.M{"reminded": true}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func mongoModelToTodo(m todoModel) todo {
+	return todo{
+		ID:        m.ID.Hex(),
+		Title:     m.Title,
+		Completed: m.Completed,
+		CreatedAt: m.CreatedAt,
+		DueAt:     m.DueAt,
+		Reminded:  m.Reminded,
+		UserID:    m.UserID,
+	}
+}
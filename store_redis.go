@@ -0,0 +1,249 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/rs/xid"
+)
+
+// redisTodoKeyPrefix namespaces every todo key so SCAN can select just the
+// todos without touching other keys that might live in the same database.
+const redisTodoKeyPrefix = "todo:"
+
+// redisTodoRecord is the JSON shape stored under each "todo:<id>" key. It
+// mirrors todo but carries its own tags since todo.UserID is deliberately
+// excluded from the public API response.
+type redisTodoRecord struct {
+	ID        string     `json:"id"`
+	Title     string     `json:"title"`
+	Completed bool       `json:"completed"`
+	CreatedAt time.Time  `json:"created_at"`
+	DueAt     *time.Time `json:"due_at,omitempty"`
+	Reminded  bool       `json:"reminded"`
+	UserID    string     `json:"user_id"`
+}
+
+func (rec redisTodoRecord) toTodo() todo {
+	return todo{
+		ID:        rec.ID,
+		Title:     rec.Title,
+		Completed: rec.Completed,
+		CreatedAt: rec.CreatedAt,
+		DueAt:     rec.DueAt,
+		Reminded:  rec.Reminded,
+		UserID:    rec.UserID,
+	}
+}
+
+// redisTodoStore stores each todo as a JSON blob under "todo:<id>". List
+// walks the keyspace with SCAN rather than KEYS so it stays safe to run
+// against a large, shared Redis instance.
+type redisTodoStore struct {
+	client *redis.Client
+}
+
+func newRedisTodoStore() *redisTodoStore {
+	addr := os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		addr = "localhost:6379"
+	}
+	return &redisTodoStore{client: redis.NewClient(&redis.Options{Addr: addr})}
+}
+
+func (s *redisTodoStore) List(ctx context.Context, userID string, params ListParams) (ListResult, error) {
+	matched := []todo{}
+	iter := s.client.Scan(ctx, 0, redisTodoKeyPrefix+"*", 0).Iterator()
+	for iter.Next(ctx) {
+		raw, err := s.client.Get(ctx, iter.Val()).Bytes()
+		if err != nil {
+			return ListResult{}, err
+		}
+		var rec redisTodoRecord
+		if err := json.Unmarshal(raw, &rec); err != nil {
+			return ListResult{}, err
+		}
+		if rec.UserID != userID {
+			continue
+		}
+		if params.Completed != nil && rec.Completed != *params.Completed {
+			continue
+		}
+		if params.Query != "" && !strings.Contains(strings.ToLower(rec.Title), strings.ToLower(params.Query)) {
+			continue
+		}
+		matched = append(matched, rec.toTodo())
+	}
+	if err := iter.Err(); err != nil {
+		return ListResult{}, err
+	}
+
+	sortRedisTodos(matched, params.SortColumn, params.SortOrder)
+
+	total := len(matched)
+	start := params.Offset
+	if start > total {
+		start = total
+	}
+	end := start + params.Limit
+	if end > total {
+		end = total
+	}
+	return ListResult{Todos: matched[start:end], Total: total}, nil
+}
+
+// sortRedisTodos sorts in place, since unlike Mongo/SQL, Redis has no
+// server-side ORDER BY for this key layout. Unknown columns fall back to
+// created_at.
+func sortRedisTodos(todos []todo, column, order string) {
+	less := func(i, j int) bool {
+		var ascending bool
+		switch column {
+		case "title":
+			ascending = todos[i].Title < todos[j].Title
+		case "completed":
+			ascending = !todos[i].Completed && todos[j].Completed
+		default:
+			ascending = todos[i].CreatedAt.Before(todos[j].CreatedAt)
+		}
+		if order == "desc" {
+			return !ascending
+		}
+		return ascending
+	}
+	sort.Slice(todos, less)
+}
+
+// getRecord fetches the raw record for id without checking ownership; all
+// exported methods below must check UserID themselves so one account can
+// never read, modify or delete another account's todo by guessing its id.
+func (s *redisTodoStore) getRecord(ctx context.Context, id string) (redisTodoRecord, error) {
+	if _, err := xid.FromString(id); err != nil {
+		return redisTodoRecord{}, ErrInvalidID
+	}
+	raw, err := s.client.Get(ctx, redisTodoKeyPrefix+id).Bytes()
+	if err == redis.Nil {
+		return redisTodoRecord{}, ErrNotFound
+	}
+	if err != nil {
+		return redisTodoRecord{}, err
+	}
+	var rec redisTodoRecord
+	if err := json.Unmarshal(raw, &rec); err != nil {
+		return redisTodoRecord{}, err
+	}
+	return rec, nil
+}
+
+func (s *redisTodoStore) Get(ctx context.Context, userID, id string) (todo, error) {
+	rec, err := s.getRecord(ctx, id)
+	if err != nil {
+		return todo{}, err
+	}
+	if rec.UserID != userID {
+		return todo{}, ErrNotFound
+	}
+	return rec.toTodo(), nil
+}
+
+func (s *redisTodoStore) Create(ctx context.Context, userID string, t todo) (todo, error) {
+	rec := redisTodoRecord{
+		ID:        xid.New().String(),
+		Title:     t.Title,
+		Completed: t.Completed,
+		CreatedAt: time.Now(),
+		DueAt:     t.DueAt,
+		UserID:    userID,
+	}
+	raw, err := json.Marshal(rec)
+	if err != nil {
+		return todo{}, err
+	}
+	if err := s.client.Set(ctx, redisTodoKeyPrefix+rec.ID, raw, 0).Err(); err != nil {
+		return todo{}, err
+	}
+	return rec.toTodo(), nil
+}
+
+// Update resets Reminded to false on every edit, since the reminder worker
+// should get another chance to notify about whatever the todo now looks
+// like (e.g. a pushed-out due_at).
+func (s *redisTodoStore) Update(ctx context.Context, userID, id string, t todo) error {
+	existing, err := s.getRecord(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing.UserID != userID {
+		return ErrNotFound
+	}
+	rec := redisTodoRecord{
+		ID:        id,
+		Title:     t.Title,
+		Completed: t.Completed,
+		CreatedAt: existing.CreatedAt,
+		DueAt:     t.DueAt,
+		Reminded:  false,
+		UserID:    userID,
+	}
+	raw, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	return s.client.Set(ctx, redisTodoKeyPrefix+id, raw, 0).Err()
+}
+
+func (s *redisTodoStore) Delete(ctx context.Context, userID, id string) error {
+	existing, err := s.getRecord(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing.UserID != userID {
+		return ErrNotFound
+	}
+	n, err := s.client.Del(ctx, redisTodoKeyPrefix+id).Result()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *redisTodoStore) DueTodos(ctx context.Context, asOf time.Time) ([]todo, error) {
+	due := []todo{}
+	iter := s.client.Scan(ctx, 0, redisTodoKeyPrefix+"*", 0).Iterator()
+	for iter.Next(ctx) {
+		raw, err := s.client.Get(ctx, iter.Val()).Bytes()
+		if err != nil {
+			return nil, err
+		}
+		var rec redisTodoRecord
+		if err := json.Unmarshal(raw, &rec); err != nil {
+			return nil, err
+		}
+		if rec.Completed || rec.Reminded || rec.DueAt == nil || rec.DueAt.After(asOf) {
+			continue
+		}
+		due = append(due, rec.toTodo())
+	}
+	return due, iter.Err()
+}
+
+func (s *redisTodoStore) MarkReminded(ctx context.Context, id string) error {
+	rec, err := s.getRecord(ctx, id)
+	if err != nil {
+		return err
+	}
+	rec.Reminded = true
+	raw, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	return s.client.Set(ctx, redisTodoKeyPrefix+id, raw, 0).Err()
+}
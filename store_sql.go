@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/rs/xid"
+
+	"github.com/sxmbaka/go-todo/ent"
+	enttodo "github.com/sxmbaka/go-todo/ent/todo"
+)
+
+// sqlTodoStore is a SQL-backed TodoStore built on the client entgo.io
+// generates from ./ent/schema/todo.go (run `go generate ./...` after
+// touching the schema). It defaults to a local sqlite file; TODO_SQL_DSN
+// overrides that DSN. The client is always opened with ent's SQLite
+// dialect and only the sqlite3 driver is linked in, so a MySQL or Postgres
+// DSN is not supported.
+type sqlTodoStore struct {
+	client *ent.Client
+}
+
+func newSQLTodoStore() *sqlTodoStore {
+	dsn := os.Getenv("TODO_SQL_DSN")
+	if dsn == "" {
+		dsn = "file:go-todo.db?cache=shared&_fk=1"
+	}
+	client, err := ent.Open(dialect.SQLite, dsn)
+	checkErr(err)
+	if err := client.Schema.Create(context.Background()); err != nil {
+		log.Fatalf("sql: failed creating schema: %v", err)
+	}
+	return &sqlTodoStore{client: client}
+}
+
+func (s *sqlTodoStore) List(ctx context.Context, userID string, params ListParams) (ListResult, error) {
+	query := s.client.Todo.Query().Where(enttodo.OwnerID(userID))
+	if params.Completed != nil {
+		query = query.Where(enttodo.CompletedEQ(*params.Completed))
+	}
+	if params.Query != "" {
+		query = query.Where(enttodo.TitleContainsFold(params.Query))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return ListResult{}, err
+	}
+
+	orderFn := ent.Asc
+	if params.SortOrder == "desc" {
+		orderFn = ent.Desc
+	}
+	rows, err := query.
+		Order(orderFn(params.SortColumn)).
+		Offset(params.Offset).
+		Limit(params.Limit).
+		All(ctx)
+	if err != nil {
+		return ListResult{}, err
+	}
+
+	todos := make([]todo, 0, len(rows))
+	for _, row := range rows {
+		todos = append(todos, sqlRowToTodo(row))
+	}
+	return ListResult{Todos: todos, Total: total}, nil
+}
+
+func (s *sqlTodoStore) Get(ctx context.Context, userID, id string) (todo, error) {
+	if _, err := xid.FromString(id); err != nil {
+		return todo{}, ErrInvalidID
+	}
+	row, err := s.client.Todo.Query().
+		Where(enttodo.ID(id), enttodo.OwnerID(userID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return todo{}, ErrNotFound
+	}
+	if err != nil {
+		return todo{}, err
+	}
+	return sqlRowToTodo(row), nil
+}
+
+func (s *sqlTodoStore) Create(ctx context.Context, userID string, t todo) (todo, error) {
+	row, err := s.client.Todo.Create().
+		SetTitle(t.Title).
+		SetCompleted(t.Completed).
+		SetNillableDueAt(t.DueAt).
+		SetOwnerID(userID).
+		Save(ctx)
+	if err != nil {
+		return todo{}, err
+	}
+	return sqlRowToTodo(row), nil
+}
+
+// Update resets reminded to false on every edit, since the reminder worker
+// should get another chance to notify about whatever the todo now looks
+// like (e.g. a pushed-out due_at).
+func (s *sqlTodoStore) Update(ctx context.Context, userID, id string, t todo) error {
+	if _, err := xid.FromString(id); err != nil {
+		return ErrInvalidID
+	}
+	update := s.client.Todo.Update().
+		Where(enttodo.ID(id), enttodo.OwnerID(userID)).
+		SetTitle(t.Title).
+		SetCompleted(t.Completed).
+		SetReminded(false)
+	if t.DueAt != nil {
+		update = update.SetDueAt(*t.DueAt)
+	} else {
+		update = update.ClearDueAt()
+	}
+	n, err := update.Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *sqlTodoStore) Delete(ctx context.Context, userID, id string) error {
+	if _, err := xid.FromString(id); err != nil {
+		return ErrInvalidID
+	}
+	n, err := s.client.Todo.Delete().
+		Where(enttodo.ID(id), enttodo.OwnerID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *sqlTodoStore) DueTodos(ctx context.Context, asOf time.Time) ([]todo, error) {
+	rows, err := s.client.Todo.Query().
+		Where(
+			enttodo.CompletedEQ(false),
+			enttodo.RemindedEQ(false),
+			enttodo.DueAtNotNil(),
+			enttodo.DueAtLTE(asOf),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	todos := make([]todo, 0, len(rows))
+	for _, row := range rows {
+		todos = append(todos, sqlRowToTodo(row))
+	}
+	return todos, nil
+}
+
+func (s *sqlTodoStore) MarkReminded(ctx context.Context, id string) error {
+	if _, err := xid.FromString(id); err != nil {
+		return ErrInvalidID
+	}
+	err := s.client.Todo.UpdateOneID(id).SetReminded(true).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return ErrNotFound
+	}
+	return err
+}
+
+func sqlRowToTodo(row *ent.Todo) todo {
+	return todo{
+		ID:        row.ID,
+		Title:     row.Title,
+		Completed: row.Completed,
+		CreatedAt: row.CreatedAt,
+		DueAt:     row.DueAt,
+		Reminded:  row.Reminded,
+		UserID:    row.OwnerID,
+	}
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const usersCollectionName = "users"
+
+// ErrUserExists is returned by userStore.Create when the username is
+// already registered.
+var ErrUserExists = errors.New("auth: username already registered")
+
+// ErrInvalidCredentials is returned by userStore.Authenticate when the
+// username doesn't exist or the password doesn't match.
+var ErrInvalidCredentials = errors.New("auth: invalid username or password")
+
+// userModel is the document shape stored in MongoDB's "users" collection.
+type userModel struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	Username     string             `bson:"username"`
+	PasswordHash string             `bson:"password_hash"`
+	CreatedAt    time.Time          `bson:"created_at"`
+}
+
+// userStore manages the accounts todos are scoped to. It's always backed by
+// Mongo, independent of TODO_BACKEND, since accounts aren't part of the
+// pluggable todo persistence.
+type userStore struct {
+	collection *mongo.Collection
+}
+
+func newUserStore(ctx context.Context) (*userStore, error) {
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(mongoURI))
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		return nil, err
+	}
+	collection := client.Database(dbName).Collection(usersCollectionName)
+	_, err = collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "username", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &userStore{collection: collection}, nil
+}
+
+func (s *userStore) Create(ctx context.Context, username, password string) (userModel, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return userModel{}, err
+	}
+	u := userModel{
+		ID:           primitive.NewObjectID(),
+		Username:     username,
+		PasswordHash: string(hash),
+		CreatedAt:    time.Now(),
+	}
+	if _, err := s.collection.InsertOne(ctx, u); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return userModel{}, ErrUserExists
+		}
+		return userModel{}, err
+	}
+	return u, nil
+}
+
+func (s *userStore) Authenticate(ctx context.Context, username, password string) (userModel, error) {
+	var u userModel
+	if err := s.collection.FindOne(ctx, bson.M{"username": username}).Decode(&u); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return userModel{}, ErrInvalidCredentials
+		}
+		return userModel{}, err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)); err != nil {
+		return userModel{}, ErrInvalidCredentials
+	}
+	return u, nil
+}